@@ -8,22 +8,61 @@ import (
 	"flag"
 	"fmt"
 	"io"
+	"math/rand"
 	"mime/multipart"
 	"net/http"
+	"net/url"
 	"os"
+	"os/signal"
 	"path"
 	"path/filepath"
+	"strconv"
 	"strings"
+	"sync"
+	"time"
+	"unicode"
 )
 
+// followBatchMaxBytes is the default batch size for --follow mode, left
+// under Discord's 2000 character message cap to leave room for usernames
+// and any formatting the caller adds.
+const followBatchMaxBytes = 1800
+
+// minMessageLength is the lowest --max-length splitMessage will honor, a
+// sanity floor so a very small override can't collapse every chunk's
+// budget (after reserving room for fence reopen/close overhead) down to
+// one or two runes.
+const minMessageLength = 32
+
 var (
-	configure  bool
-	helpFlag   bool
-	version    bool
-	username   string
-	channel    string
-	files      []string
-	webhookURL string
+	configure     bool
+	helpFlag      bool
+	version       bool
+	username      string
+	channel       string
+	files         []string
+	webhookURL    string
+	profileNames  []string
+	allProfiles   bool
+	maxRetries    int
+	followMode    bool
+	flushInterval time.Duration
+
+	embedTitle       string
+	embedDescription string
+	embedColor       string
+	embedFields      []string
+	embedFooter      string
+	embedImage       string
+	embedTimestamp   bool
+	payloadJSON      string
+
+	threadID    string
+	waitFlag    bool
+	editMsgID   string
+	deleteMsgID string
+
+	maxMessageLength int
 )
 
 const (
@@ -32,9 +71,70 @@ const (
 	DefaultFileName = "no_name"
 )
 
-type Config struct {
+// Profile is a named webhook destination, allowing a single config file to
+// fan out notifications to many Discord channels/bots.
+type Profile struct {
+	Name       string `json:"name"`
 	WebhookURL string `json:"webhook_url"`
-	Username   string `json:"username"`
+	Username   string `json:"username,omitempty"`
+	AvatarURL  string `json:"avatar_url,omitempty"`
+	ThreadID   string `json:"thread_id,omitempty"`
+}
+
+type Config struct {
+	WebhookURL string    `json:"webhook_url"`
+	Username   string    `json:"username"`
+	Default    string    `json:"default,omitempty"`
+	Profiles   []Profile `json:"profiles,omitempty"`
+}
+
+// webhookTarget is a resolved destination to post a single payload to.
+type webhookTarget struct {
+	Name       string
+	WebhookURL string
+	Username   string
+	AvatarURL  string
+	ThreadID   string
+}
+
+// Embed mirrors the subset of Discord's embed object that discocat can
+// build from flags.
+type Embed struct {
+	Title       string       `json:"title,omitempty"`
+	Description string       `json:"description,omitempty"`
+	Color       int          `json:"color,omitempty"`
+	Footer      *EmbedFooter `json:"footer,omitempty"`
+	Image       *EmbedImage  `json:"image,omitempty"`
+	Timestamp   string       `json:"timestamp,omitempty"`
+	Fields      []EmbedField `json:"fields,omitempty"`
+}
+
+type EmbedFooter struct {
+	Text string `json:"text"`
+}
+
+type EmbedImage struct {
+	URL string `json:"url"`
+}
+
+type EmbedField struct {
+	Name  string `json:"name"`
+	Value string `json:"value"`
+}
+
+// namedEmbedColors maps a handful of common color names to their decimal
+// RGB value, as an alternative to passing --embed-color as hex.
+var namedEmbedColors = map[string]int{
+	"red":    0xFF0000,
+	"green":  0x00FF00,
+	"blue":   0x0000FF,
+	"yellow": 0xFFFF00,
+	"orange": 0xFFA500,
+	"purple": 0x800080,
+	"black":  0x000000,
+	"white":  0xFFFFFF,
+	"gray":   0x808080,
+	"grey":   0x808080,
 }
 
 func init() {
@@ -56,6 +156,34 @@ func init() {
 		return nil
 	})
 	flag.StringVar(&webhookURL, "webhook", "", "Specify the webhook URL")
+	flag.Func("profile", "Send to a named webhook profile from the config file. May be repeated.", func(v string) error {
+		profileNames = append(profileNames, v)
+		return nil
+	})
+	flag.BoolVar(&allProfiles, "all", false, "Fan out to every configured webhook profile")
+	flag.IntVar(&maxRetries, "max-retries", 5, "Maximum number of retries on rate limit or server errors")
+	flag.BoolVar(&followMode, "follow", false, "Tail stdin, batching lines into messages as they arrive")
+	flag.BoolVar(&followMode, "F", false, "Tail stdin, batching lines into messages as they arrive (shorthand)")
+	flag.DurationVar(&flushInterval, "flush-interval", 2*time.Second, "Maximum time to buffer lines in --follow mode before sending")
+
+	flag.StringVar(&embedTitle, "embed-title", "", "Set the title of a rich embed")
+	flag.StringVar(&embedDescription, "embed-description", "", "Set the description of a rich embed")
+	flag.StringVar(&embedColor, "embed-color", "", "Set the embed color (hex #RRGGBB or a known color name)")
+	flag.Func("embed-field", "Add an embed field as name=value. May be repeated.", func(v string) error {
+		embedFields = append(embedFields, v)
+		return nil
+	})
+	flag.StringVar(&embedFooter, "embed-footer", "", "Set the footer text of a rich embed")
+	flag.StringVar(&embedImage, "embed-image", "", "Set the image URL of a rich embed")
+	flag.BoolVar(&embedTimestamp, "embed-timestamp", false, "Stamp the embed with the current time")
+	flag.StringVar(&payloadJSON, "payload-json", "", "Use a raw JSON payload from @file.json instead of building one from flags")
+
+	flag.StringVar(&threadID, "thread", "", "Target a specific thread by ID")
+	flag.BoolVar(&waitFlag, "wait", false, "Wait for Discord to return the created message and print its ID")
+	flag.StringVar(&editMsgID, "edit", "", "Edit an existing message by ID instead of posting a new one")
+	flag.StringVar(&deleteMsgID, "delete", "", "Delete an existing message by ID")
+
+	flag.IntVar(&maxMessageLength, "max-length", 2000, "Override the per-message character limit used to split long content (minimum 32)")
 }
 
 func main() {
@@ -96,49 +224,471 @@ func main() {
 		os.Exit(1)
 	}
 
-	// Use the webhook URL specified in the command line options or the configuration file
-	if webhookURL == "" {
-		webhookURL = config.WebhookURL
+	targets, err := resolveTargets(config, webhookURL, username, profileNames, allProfiles)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, "Error:", err)
+		os.Exit(1)
 	}
 
-	if webhookURL == "" {
-		fmt.Fprintln(os.Stderr, "Error: Webhook URL is not specified. Use the --webhook option or run --configure.")
+	if editMsgID != "" && deleteMsgID != "" {
+		fmt.Fprintln(os.Stderr, "Error: --edit and --delete cannot be used together")
 		os.Exit(1)
 	}
 
-	// Use the username specified in the command line options or the configuration file
-	if username == "" {
-		username = config.Username
+	if deleteMsgID != "" {
+		if err := deleteFromTargets(targets, deleteMsgID, maxRetries); err != nil {
+			os.Exit(1)
+		}
+		fmt.Println("Message deleted successfully.")
+		return
 	}
 
-	// Prepare the payload and send the message
-	if len(files) != 0 {
-		err = sendFile(webhookURL, files, username)
+	if editMsgID != "" {
+		payloadBytes, err := buildEditPayload()
 		if err != nil {
 			fmt.Fprintln(os.Stderr, "Error:", err)
 			os.Exit(1)
 		}
-	} else {
-		// Read content from stdin
-		content, err := getContent()
+		if err := editTargets(targets, editMsgID, files, payloadBytes, maxRetries); err != nil {
+			os.Exit(1)
+		}
+		fmt.Println("Message edited successfully.")
+		return
+	}
+
+	if payloadJSON != "" {
+		raw, err := loadPayloadJSON(payloadJSON)
 		if err != nil {
 			fmt.Fprintln(os.Stderr, "Error:", err)
 			os.Exit(1)
 		}
+		if err := sendRawPayloadToTargets(targets, files, raw, maxRetries); err != nil {
+			os.Exit(1)
+		}
+		fmt.Println("Notification sent successfully.")
+		return
+	}
+
+	if followMode {
+		if len(files) != 0 {
+			fmt.Fprintln(os.Stderr, "Error: --follow cannot be combined with --file")
+			os.Exit(1)
+		}
+		if err := runFollow(targets, maxRetries); err != nil {
+			fmt.Fprintln(os.Stderr, "Error:", err)
+			os.Exit(1)
+		}
+		return
+	}
 
-		// Send the message content
-		err = sendMessage(webhookURL, content, username)
+	// Prepare the payload once; fan out to every resolved target.
+	var content string
+	if len(files) == 0 {
+		content, err = getContent()
 		if err != nil {
 			fmt.Fprintln(os.Stderr, "Error:", err)
 			os.Exit(1)
 		}
 	}
 
+	if err := sendToTargets(targets, content, files, maxRetries); err != nil {
+		os.Exit(1)
+	}
+
 	fmt.Println("Notification sent successfully.")
 }
 
+// buildEmbed assembles an *Embed from the --embed-* flags, or returns a nil
+// embed if none of them were set. It validates Discord's documented embed
+// limits up front so a malformed embed never reaches the API.
+func buildEmbed() (*Embed, error) {
+	if embedTitle == "" && embedDescription == "" && embedColor == "" && embedFooter == "" && embedImage == "" && len(embedFields) == 0 && !embedTimestamp {
+		return nil, nil
+	}
+
+	if len([]rune(embedTitle)) > 256 {
+		return nil, errors.New("--embed-title exceeds Discord's 256 character limit")
+	}
+	if len([]rune(embedDescription)) > 4096 {
+		return nil, errors.New("--embed-description exceeds Discord's 4096 character limit")
+	}
+	if len(embedFields) > 25 {
+		return nil, fmt.Errorf("%d embed fields given, Discord allows at most 25", len(embedFields))
+	}
+
+	embed := &Embed{Title: embedTitle, Description: embedDescription}
+	total := len([]rune(embedTitle)) + len([]rune(embedDescription))
+
+	if embedColor != "" {
+		color, err := parseEmbedColor(embedColor)
+		if err != nil {
+			return nil, err
+		}
+		embed.Color = color
+	}
+
+	if embedFooter != "" {
+		embed.Footer = &EmbedFooter{Text: embedFooter}
+		total += len([]rune(embedFooter))
+	}
+
+	if embedImage != "" {
+		embed.Image = &EmbedImage{URL: embedImage}
+	}
+
+	if embedTimestamp {
+		embed.Timestamp = time.Now().UTC().Format(time.RFC3339)
+	}
+
+	for _, raw := range embedFields {
+		name, value, ok := strings.Cut(raw, "=")
+		if !ok {
+			return nil, fmt.Errorf("invalid --embed-field %q, expected name=value", raw)
+		}
+		embed.Fields = append(embed.Fields, EmbedField{Name: name, Value: value})
+		total += len([]rune(name)) + len([]rune(value))
+	}
+
+	if total > 6000 {
+		return nil, fmt.Errorf("embed content totals %d characters, Discord allows at most 6000", total)
+	}
+
+	return embed, nil
+}
+
+func parseEmbedColor(s string) (int, error) {
+	if color, ok := namedEmbedColors[strings.ToLower(s)]; ok {
+		return color, nil
+	}
+	value, err := strconv.ParseInt(strings.TrimPrefix(s, "#"), 16, 32)
+	if err != nil {
+		return 0, fmt.Errorf("invalid --embed-color %q: must be a hex code (#RRGGBB) or a known color name", s)
+	}
+	return int(value), nil
+}
+
+// loadPayloadJSON reads the raw JSON payload referenced by a --payload-json
+// value, which must be of the form "@path/to/file.json".
+func loadPayloadJSON(spec string) ([]byte, error) {
+	path, ok := strings.CutPrefix(spec, "@")
+	if !ok {
+		return nil, fmt.Errorf("--payload-json must reference a file as @file.json, got %q", spec)
+	}
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read payload JSON file: %w", err)
+	}
+	if !json.Valid(data) {
+		return nil, fmt.Errorf("%s does not contain valid JSON", path)
+	}
+	return data, nil
+}
+
+// sendToTargets fans the given content (or files) out to every target in
+// parallel, printing a per-target error for each failure.
+func sendToTargets(targets []webhookTarget, content string, filePaths []string, maxRetries int) error {
+	var wg sync.WaitGroup
+	errs := make([]error, len(targets))
+	for i, target := range targets {
+		wg.Add(1)
+		go func(i int, target webhookTarget) {
+			defer wg.Done()
+			targetURL, err := withThreadAndWait(target.WebhookURL, target.ThreadID)
+			if err != nil {
+				errs[i] = err
+				return
+			}
+			if len(filePaths) != 0 {
+				errs[i] = sendFile(targetURL, filePaths, target.Username, target.AvatarURL, maxRetries, waitFlag)
+			} else {
+				errs[i] = sendMessage(targetURL, content, target.Username, target.AvatarURL, maxRetries, waitFlag, maxMessageLength)
+			}
+		}(i, target)
+	}
+	wg.Wait()
+
+	failed := false
+	for i, target := range targets {
+		if errs[i] != nil {
+			failed = true
+			fmt.Fprintf(os.Stderr, "Error: [%s] %s\n", target.Name, errs[i])
+		}
+	}
+	if failed {
+		return errors.New("failed to send to one or more targets")
+	}
+	return nil
+}
+
+// sendRawPayloadToTargets fans a user-supplied --payload-json body out to
+// every target in parallel, printing a per-target error for each failure.
+func sendRawPayloadToTargets(targets []webhookTarget, filePaths []string, payloadBytes []byte, maxRetries int) error {
+	var wg sync.WaitGroup
+	errs := make([]error, len(targets))
+	for i, target := range targets {
+		wg.Add(1)
+		go func(i int, target webhookTarget) {
+			defer wg.Done()
+			targetURL, err := withThreadAndWait(target.WebhookURL, target.ThreadID)
+			if err != nil {
+				errs[i] = err
+				return
+			}
+			errs[i] = sendRawPayload(targetURL, filePaths, payloadBytes, maxRetries, waitFlag)
+		}(i, target)
+	}
+	wg.Wait()
+
+	failed := false
+	for i, target := range targets {
+		if errs[i] != nil {
+			failed = true
+			fmt.Fprintf(os.Stderr, "Error: [%s] %s\n", target.Name, errs[i])
+		}
+	}
+	if failed {
+		return errors.New("failed to send to one or more targets")
+	}
+	return nil
+}
+
+// buildEditPayload assembles the payload_json body for --edit from
+// --payload-json (if given) or from the same content/username/embed flags
+// a normal send would use. Unlike a normal send, stdin content is optional
+// when --file is given, since an edit may only be replacing attachments.
+func buildEditPayload() ([]byte, error) {
+	if payloadJSON != "" {
+		return loadPayloadJSON(payloadJSON)
+	}
+
+	var content string
+	var err error
+	if len(files) == 0 {
+		content, err = getContent()
+	} else {
+		content, err = getContentOptional()
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	embed, err := buildEmbed()
+	if err != nil {
+		return nil, err
+	}
+
+	payload := map[string]interface{}{}
+	if content != "" {
+		payload["content"] = content
+	}
+	if username != "" {
+		payload["username"] = username
+	}
+	if embed != nil {
+		payload["embeds"] = []*Embed{embed}
+	}
+	return json.Marshal(payload)
+}
+
+// editTargets fans a --edit out to every target in parallel, printing a
+// per-target error for each failure.
+func editTargets(targets []webhookTarget, messageID string, filePaths []string, payloadBytes []byte, maxRetries int) error {
+	var wg sync.WaitGroup
+	errs := make([]error, len(targets))
+	for i, target := range targets {
+		wg.Add(1)
+		go func(i int, target webhookTarget) {
+			defer wg.Done()
+			errs[i] = editMessage(target.WebhookURL, messageID, filePaths, payloadBytes, maxRetries)
+		}(i, target)
+	}
+	wg.Wait()
+
+	failed := false
+	for i, target := range targets {
+		if errs[i] != nil {
+			failed = true
+			fmt.Fprintf(os.Stderr, "Error: [%s] %s\n", target.Name, errs[i])
+		}
+	}
+	if failed {
+		return errors.New("failed to edit the message on one or more targets")
+	}
+	return nil
+}
+
+// deleteFromTargets fans a --delete out to every target in parallel,
+// printing a per-target error for each failure.
+func deleteFromTargets(targets []webhookTarget, messageID string, maxRetries int) error {
+	var wg sync.WaitGroup
+	errs := make([]error, len(targets))
+	for i, target := range targets {
+		wg.Add(1)
+		go func(i int, target webhookTarget) {
+			defer wg.Done()
+			errs[i] = deleteMessage(target.WebhookURL, messageID, maxRetries)
+		}(i, target)
+	}
+	wg.Wait()
+
+	failed := false
+	for i, target := range targets {
+		if errs[i] != nil {
+			failed = true
+			fmt.Fprintf(os.Stderr, "Error: [%s] %s\n", target.Name, errs[i])
+		}
+	}
+	if failed {
+		return errors.New("failed to delete the message on one or more targets")
+	}
+	return nil
+}
+
+// runFollow tails stdin without requiring it to reach EOF first: lines are
+// buffered until either followBatchMaxBytes have accumulated or
+// flushInterval has elapsed since the first buffered line, then posted as a
+// single message. It flushes any pending buffer on stdin EOF or SIGINT.
+func runFollow(targets []webhookTarget, maxRetries int) error {
+	sigCh := make(chan os.Signal, 1)
+	signal.Notify(sigCh, os.Interrupt)
+	defer signal.Stop(sigCh)
+
+	lines := make(chan string)
+	scanErrCh := make(chan error, 1)
+	go func() {
+		defer close(lines)
+		scanner := bufio.NewScanner(os.Stdin)
+		scanner.Buffer(make([]byte, 0, 64*1024), 1024*1024)
+		for scanner.Scan() {
+			lines <- scanner.Text()
+		}
+		if err := scanner.Err(); err != nil {
+			scanErrCh <- err
+		}
+	}()
+
+	var buf strings.Builder
+	var timer *time.Timer
+	var timerCh <-chan time.Time
+
+	flush := func() {
+		if buf.Len() == 0 {
+			return
+		}
+		content := buf.String()
+		buf.Reset()
+		if timer != nil {
+			timer.Stop()
+			timer = nil
+			timerCh = nil
+		}
+		if err := sendToTargets(targets, content, nil, maxRetries); err != nil {
+			fmt.Fprintln(os.Stderr, "Error: failed to flush batch:", err)
+		}
+	}
+
+	for {
+		select {
+		case line, ok := <-lines:
+			if !ok {
+				flush()
+				select {
+				case err := <-scanErrCh:
+					return fmt.Errorf("failed to read from stdin: %w", err)
+				default:
+					return nil
+				}
+			}
+			if buf.Len() > 0 {
+				buf.WriteByte('\n')
+			}
+			buf.WriteString(line)
+			if timer == nil {
+				timer = time.NewTimer(flushInterval)
+				timerCh = timer.C
+			}
+			if buf.Len() >= followBatchMaxBytes {
+				flush()
+			}
+		case <-timerCh:
+			flush()
+		case <-sigCh:
+			flush()
+			return nil
+		}
+	}
+}
+
+// resolveTargets determines which webhook(s) to post the payload to, mixing
+// an ad-hoc --webhook URL with one or more --profile selections, or fanning
+// out to every configured profile when --all is set.
+func resolveTargets(config Config, adHocWebhook, adHocUsername string, profileNames []string, all bool) ([]webhookTarget, error) {
+	byName := make(map[string]Profile, len(config.Profiles))
+	for _, p := range config.Profiles {
+		byName[p.Name] = p
+	}
+
+	var targets []webhookTarget
+	if adHocWebhook != "" {
+		name := adHocUsername
+		if name == "" {
+			name = "adhoc"
+		}
+		targets = append(targets, webhookTarget{Name: name, WebhookURL: adHocWebhook, Username: adHocUsername, ThreadID: threadID})
+	}
+
+	if all {
+		for _, p := range config.Profiles {
+			targets = append(targets, webhookTarget{Name: p.Name, WebhookURL: p.WebhookURL, Username: firstNonEmpty(adHocUsername, p.Username), AvatarURL: p.AvatarURL, ThreadID: firstNonEmpty(threadID, p.ThreadID)})
+		}
+	} else {
+		for _, name := range profileNames {
+			p, ok := byName[name]
+			if !ok {
+				return nil, fmt.Errorf("profile %q is not configured", name)
+			}
+			targets = append(targets, webhookTarget{Name: p.Name, WebhookURL: p.WebhookURL, Username: firstNonEmpty(adHocUsername, p.Username), AvatarURL: p.AvatarURL, ThreadID: firstNonEmpty(threadID, p.ThreadID)})
+		}
+	}
+
+	if len(targets) == 0 {
+		// No --webhook/--profile/--all given: fall back to the default
+		// profile, or the legacy top-level webhook_url/username fields.
+		if config.Default != "" {
+			p, ok := byName[config.Default]
+			if !ok {
+				return nil, fmt.Errorf("default profile %q is not configured", config.Default)
+			}
+			targets = append(targets, webhookTarget{Name: p.Name, WebhookURL: p.WebhookURL, Username: firstNonEmpty(adHocUsername, p.Username), AvatarURL: p.AvatarURL, ThreadID: firstNonEmpty(threadID, p.ThreadID)})
+		} else if config.WebhookURL != "" {
+			targets = append(targets, webhookTarget{Name: "default", WebhookURL: config.WebhookURL, Username: firstNonEmpty(adHocUsername, config.Username), ThreadID: threadID})
+		}
+	}
+
+	if len(targets) == 0 {
+		return nil, errors.New("webhook URL is not specified. Use the --webhook option, --profile, or run --configure")
+	}
+
+	return targets, nil
+}
+
+func firstNonEmpty(values ...string) string {
+	for _, v := range values {
+		if v != "" {
+			return v
+		}
+	}
+	return ""
+}
+
 func runConfigure(configPath string) error {
-	config := Config{}
+	// Load the existing configuration, if any, so that --configure appends
+	// a new profile instead of clobbering previously configured webhooks.
+	config, err := loadConfig(configPath)
+	if err != nil && !errors.Is(err, os.ErrNotExist) {
+		return fmt.Errorf("failed to load the existing configuration: %w", err)
+	}
+
 	reader := bufio.NewReader(os.Stdin)
 
 	fmt.Print("Enter the Webhook URL: ")
@@ -146,13 +696,71 @@ func runConfigure(configPath string) error {
 	if err != nil {
 		return fmt.Errorf("failed to read the webhook URL: %w", err)
 	}
-	config.WebhookURL = strings.TrimSpace(webhookInput)
-
-	// Validate the webhook URL
-	if config.WebhookURL == "" {
+	webhook := strings.TrimSpace(webhookInput)
+	if webhook == "" {
 		return errors.New("webhook URL cannot be empty")
 	}
 
+	fmt.Print("Enter a profile name (leave blank to set/replace the default webhook): ")
+	nameInput, err := reader.ReadString('\n')
+	if err != nil && err != io.EOF {
+		return fmt.Errorf("failed to read the profile name: %w", err)
+	}
+	name := strings.TrimSpace(nameInput)
+
+	fmt.Print("Enter an avatar URL (optional, leave blank to keep it unset/unchanged): ")
+	avatarInput, err := reader.ReadString('\n')
+	if err != nil && err != io.EOF {
+		return fmt.Errorf("failed to read the avatar URL: %w", err)
+	}
+	avatarURL := strings.TrimSpace(avatarInput)
+
+	fmt.Print("Enter a default thread ID (optional, leave blank to keep it unset/unchanged): ")
+	threadInput, err := reader.ReadString('\n')
+	if err != nil && err != io.EOF {
+		return fmt.Errorf("failed to read the thread ID: %w", err)
+	}
+	defaultThreadID := strings.TrimSpace(threadInput)
+
+	if name == "" {
+		config.WebhookURL = webhook
+		config.Username = firstNonEmpty(username, config.Username)
+	} else {
+		// Merge into the existing profile, if any, so that rerunning
+		// --configure to rotate a webhook URL doesn't wipe out fields
+		// (like avatar_url/thread_id) that aren't re-entered this run.
+		var existing Profile
+		replaced := false
+		for _, p := range config.Profiles {
+			if p.Name == name {
+				existing = p
+				replaced = true
+				break
+			}
+		}
+
+		profile := Profile{
+			Name:       name,
+			WebhookURL: webhook,
+			Username:   firstNonEmpty(username, existing.Username),
+			AvatarURL:  firstNonEmpty(avatarURL, existing.AvatarURL),
+			ThreadID:   firstNonEmpty(defaultThreadID, existing.ThreadID),
+		}
+		if replaced {
+			for i, p := range config.Profiles {
+				if p.Name == name {
+					config.Profiles[i] = profile
+					break
+				}
+			}
+		} else {
+			config.Profiles = append(config.Profiles, profile)
+		}
+		if config.Default == "" {
+			config.Default = name
+		}
+	}
+
 	// Save the configuration
 	configData, err := json.MarshalIndent(config, "", "  ")
 	if err != nil {
@@ -201,12 +809,191 @@ func getContent() (string, error) {
 	return content, nil
 }
 
-func sendMessage(webhookURL, content, username string) error {
-	// Discord's maximum message length is 2000 characters
-	maxLength := 2000
+// getContentOptional behaves like getContent but returns an empty string
+// instead of an error when no stdin is piped in, for commands (like --edit)
+// where new content is optional.
+func getContentOptional() (string, error) {
+	stat, err := os.Stdin.Stat()
+	if err != nil {
+		return "", fmt.Errorf("failed to get stdin information: %w", err)
+	}
+	if (stat.Mode() & os.ModeCharDevice) != 0 {
+		return "", nil
+	}
+	data, err := io.ReadAll(os.Stdin)
+	if err != nil {
+		return "", fmt.Errorf("failed to read from stdin: %w", err)
+	}
+	return string(data), nil
+}
+
+// httpClient is shared by sendMessage and sendFile so that a single
+// rate-limit state (see rateLimiter) governs both paths for a given webhook.
+var httpClient = &http.Client{}
+
+// rateLimiter tracks, per webhook URL, when it is next safe to send a
+// request so that multi-part sendMessage/sendFile calls don't get
+// throttled mid-stream.
+type rateLimiter struct {
+	mu       sync.Mutex
+	resumeAt map[string]time.Time
+}
+
+var limiter = &rateLimiter{resumeAt: make(map[string]time.Time)}
+
+func (l *rateLimiter) wait(webhookURL string) {
+	l.mu.Lock()
+	resumeAt := l.resumeAt[webhookURL]
+	l.mu.Unlock()
+	if d := time.Until(resumeAt); d > 0 {
+		time.Sleep(d)
+	}
+}
+
+// observe proactively delays the next request once Discord reports no
+// remaining requests in the current rate-limit window.
+func (l *rateLimiter) observe(webhookURL string, resp *http.Response) {
+	if resp.Header.Get("X-RateLimit-Remaining") != "0" {
+		return
+	}
+	resetAfter, err := strconv.ParseFloat(resp.Header.Get("X-RateLimit-Reset-After"), 64)
+	if err != nil {
+		return
+	}
+	l.mu.Lock()
+	l.resumeAt[webhookURL] = time.Now().Add(time.Duration(resetAfter * float64(time.Second)))
+	l.mu.Unlock()
+}
+
+// retryAfter determines how long to wait before retrying a 429 response,
+// preferring the JSON body's retry_after, then the Retry-After header, then
+// X-RateLimit-Reset-After.
+func retryAfter(resp *http.Response, body []byte) time.Duration {
+	var payload struct {
+		RetryAfter float64 `json:"retry_after"`
+	}
+	if err := json.Unmarshal(body, &payload); err == nil && payload.RetryAfter > 0 {
+		return time.Duration(payload.RetryAfter * float64(time.Second))
+	}
+	for _, header := range []string{"Retry-After", "X-RateLimit-Reset-After"} {
+		if seconds, err := strconv.ParseFloat(resp.Header.Get(header), 64); err == nil {
+			return time.Duration(seconds * float64(time.Second))
+		}
+	}
+	return time.Second
+}
+
+// backoff returns a jittered exponential delay for the given retry attempt
+// (0-indexed), used when Discord returns a 5xx error.
+func backoff(attempt int) time.Duration {
+	base := time.Duration(1<<uint(attempt)) * 500 * time.Millisecond
+	jitter := time.Duration(rand.Int63n(int64(250 * time.Millisecond)))
+	return base + jitter
+}
+
+// postToWebhook sends the request built by buildRequest, transparently
+// retrying on Discord rate limits (429) and server errors (5xx) up to
+// maxRetries times, and returns the response body on success (used by
+// --wait to recover the created message's ID). buildRequest is called
+// again on every attempt since the request body may only be read once.
+func postToWebhook(webhookURL string, maxRetries int, buildRequest func() (*http.Request, error)) ([]byte, error) {
+	var lastErr error
+	for attempt := 0; attempt <= maxRetries; attempt++ {
+		limiter.wait(webhookURL)
+
+		req, err := buildRequest()
+		if err != nil {
+			return nil, err
+		}
+
+		resp, err := httpClient.Do(req)
+		if err != nil {
+			lastErr = fmt.Errorf("failed to send the HTTP request: %w", err)
+			time.Sleep(backoff(attempt))
+			continue
+		}
+		limiter.observe(webhookURL, resp)
+
+		if resp.StatusCode == http.StatusTooManyRequests {
+			body, _ := io.ReadAll(resp.Body)
+			resp.Body.Close()
+			lastErr = fmt.Errorf("rate limited by Discord. Status Code: %d, Response Body: %s", resp.StatusCode, string(body))
+			time.Sleep(retryAfter(resp, body))
+			continue
+		}
+
+		if resp.StatusCode >= 500 {
+			body, _ := io.ReadAll(resp.Body)
+			resp.Body.Close()
+			lastErr = fmt.Errorf("received a server error from Discord. Status Code: %d, Response Body: %s", resp.StatusCode, string(body))
+			time.Sleep(backoff(attempt))
+			continue
+		}
+
+		defer func() {
+			if err := resp.Body.Close(); err != nil {
+				fmt.Fprintln(os.Stderr, "Error closing response body:", err)
+			}
+		}()
+
+		body, _ := io.ReadAll(resp.Body)
+		if resp.StatusCode != http.StatusNoContent && resp.StatusCode != http.StatusOK {
+			return nil, fmt.Errorf("received an error from Discord. Status Code: %d, Response Body: %s", resp.StatusCode, string(body))
+		}
+
+		return body, nil
+	}
+
+	return nil, fmt.Errorf("exceeded max retries (%d): %w", maxRetries, lastErr)
+}
+
+// withThreadAndWait appends Discord's ?thread_id= and/or ?wait=true query
+// parameters to a webhook URL, used to target a thread and to ask Discord
+// to return the created Message JSON (including its id).
+func withThreadAndWait(webhookURL, threadID string) (string, error) {
+	if threadID == "" && !waitFlag {
+		return webhookURL, nil
+	}
+	u, err := url.Parse(webhookURL)
+	if err != nil {
+		return "", fmt.Errorf("invalid webhook URL %q: %w", webhookURL, err)
+	}
+	q := u.Query()
+	if threadID != "" {
+		q.Set("thread_id", threadID)
+	}
+	if waitFlag {
+		q.Set("wait", "true")
+	}
+	u.RawQuery = q.Encode()
+	return u.String(), nil
+}
+
+// printMessageID parses the id field out of a Discord Message JSON body and
+// prints it to stdout, for --wait.
+func printMessageID(body []byte) error {
+	var message struct {
+		ID string `json:"id"`
+	}
+	if err := json.Unmarshal(body, &message); err != nil {
+		return fmt.Errorf("failed to parse the message ID from Discord's response: %w", err)
+	}
+	if message.ID == "" {
+		return errors.New("Discord's response did not include a message ID")
+	}
+	fmt.Println(message.ID)
+	return nil
+}
+
+func sendMessage(webhookURL, content, username, avatarURL string, maxRetries int, wait bool, maxLength int) error {
 	contents := splitMessage(content, maxLength)
 
-	for _, msgContent := range contents {
+	embed, err := buildEmbed()
+	if err != nil {
+		return err
+	}
+
+	for i, msgContent := range contents {
 		message := map[string]interface{}{
 			"content": msgContent,
 		}
@@ -214,58 +1001,225 @@ func sendMessage(webhookURL, content, username string) error {
 		if username != "" {
 			message["username"] = username
 		}
+		if avatarURL != "" {
+			message["avatar_url"] = avatarURL
+		}
+		if embed != nil && i == 0 {
+			// Attach the embed to the first chunk only; splitMessage can
+			// turn one --embed-* invocation into several messages, and
+			// Discord would otherwise render the same embed on each one.
+			message["embeds"] = []*Embed{embed}
+		}
 
 		messageBytes, err := json.Marshal(message)
 		if err != nil {
 			return fmt.Errorf("failed to encode the message to JSON: %w", err)
 		}
 
-		req, err := http.NewRequest("POST", webhookURL, bytes.NewBuffer(messageBytes))
+		body, err := postToWebhook(webhookURL, maxRetries, func() (*http.Request, error) {
+			req, err := http.NewRequest("POST", webhookURL, bytes.NewBuffer(messageBytes))
+			if err != nil {
+				return nil, fmt.Errorf("failed to create the HTTP request: %w", err)
+			}
+			req.Header.Set("Content-Type", "application/json")
+			return req, nil
+		})
 		if err != nil {
-			return fmt.Errorf("failed to create the HTTP request: %w", err)
+			return err
 		}
-		req.Header.Set("Content-Type", "application/json")
+		if wait {
+			if err := printMessageID(body); err != nil {
+				return err
+			}
+		}
+	}
 
-		client := &http.Client{}
-		resp, err := client.Do(req)
-		if err != nil {
-			return fmt.Errorf("failed to send the HTTP request: %w", err)
+	return nil
+}
+
+// splitMessage breaks content into chunks of at most maxLength runes (not
+// bytes, so multi-byte UTF-8 is never corrupted), preferring to break on a
+// newline, then on any Unicode whitespace, and only falling back to a bare
+// rune boundary when neither is available. If a split point falls inside a
+// ``` fenced code block, the block is closed at the end of one chunk and
+// reopened (with its language tag) at the start of the next so Discord
+// continues to render it correctly.
+func splitMessage(content string, maxLength int) []string {
+	if maxLength <= 0 {
+		maxLength = 2000
+	}
+	if maxLength < minMessageLength {
+		// Below this, the fence-reopen overhead (```lang\n ... \n```) can
+		// eat nearly the entire budget, degenerating into a flood of
+		// near-empty messages instead of a handful of reasonably sized
+		// ones.
+		maxLength = minMessageLength
+	}
+
+	runes := []rune(content)
+	if len(strings.TrimSpace(string(runes))) == 0 {
+		return nil
+	}
+
+	var chunks []string
+	fenceOpen := false
+	fenceLang := ""
+
+	for len(runes) > 0 {
+		reservedPrefix := 0
+		if fenceOpen {
+			reservedPrefix = len([]rune(fenceLang)) + len("```\n")
+		}
+		available := maxLength - reservedPrefix
+		if available < 1 {
+			available = 1
 		}
-		defer func() {
-			if err := resp.Body.Close(); err != nil {
-				fmt.Fprintln(os.Stderr, "Error closing response body:", err)
-			}
-		}()
 
-		if resp.StatusCode != http.StatusNoContent {
-			body, _ := io.ReadAll(resp.Body)
-			return fmt.Errorf("received an error from Discord. Status Code: %d, Response Body: %s", resp.StatusCode, string(body))
+		if len(runes) <= available {
+			chunks = append(chunks, buildMessageChunk(runes, fenceOpen, fenceLang, false, false))
+			break
+		}
+
+		// Reserve room for a closing fence marker, since this chunk is
+		// known to need one if it cuts the content mid-fence.
+		budget := available - len("\n```")
+		if budget < 1 {
+			budget = 1
+		}
+		if budget > len(runes) {
+			budget = len(runes)
+		}
+
+		idx, hardCut := findSplitIndex(runes[:budget], fenceOpen)
+		segment := runes[:idx]
+		nowOpen, nowLang := updateFenceState(segment, fenceOpen, fenceLang)
+		if chunk := buildMessageChunk(segment, fenceOpen, fenceLang, nowOpen, true); chunk != "" {
+			chunks = append(chunks, chunk)
 		}
 
-		// Optional: Add a short delay between messages to avoid hitting rate limits
-		// time.Sleep(500 * time.Millisecond)
+		if hardCut {
+			runes = runes[idx:]
+		} else {
+			// Drop the separator rune itself so the next chunk doesn't
+			// start with it and so every iteration makes forward progress.
+			runes = runes[idx+1:]
+		}
+		fenceOpen, fenceLang = nowOpen, nowLang
 	}
 
-	return nil
+	return chunks
 }
 
-func splitMessage(content string, maxLength int) []string {
-	var contents []string
-	for len(content) > maxLength {
-		splitIndex := strings.LastIndex(content[:maxLength], "\n")
-		if splitIndex == -1 {
-			splitIndex = maxLength
+// findSplitIndex picks where to cut window, preferring the last newline,
+// then the last other whitespace rune, then a hard cut at the end of the
+// window. The returned index is always in [1, len(window)] so callers
+// always make forward progress. hardCut reports whether the cut fell on a
+// separator rune (false) or had to use the window boundary itself (true).
+// fenceOpen is the fence state in effect at the start of window, used to
+// reject a newline candidate that would cut right after a fence-opening
+// ``` marker (see opensFenceOnLastLine).
+func findSplitIndex(window []rune, fenceOpen bool) (idx int, hardCut bool) {
+	for i := len(window) - 1; i >= 1; i-- {
+		if window[i] != '\n' {
+			continue
 		}
-		contents = append(contents, strings.TrimSpace(content[:splitIndex]))
-		content = content[splitIndex:]
+		if opensFenceOnLastLine(window[:i], fenceOpen) {
+			continue
+		}
+		return i, false
 	}
-	if len(strings.TrimSpace(content)) > 0 {
-		contents = append(contents, strings.TrimSpace(content))
+	for i := len(window) - 1; i >= 1; i-- {
+		if unicode.IsSpace(window[i]) {
+			return i, false
+		}
 	}
-	return contents
+	return len(window), true
 }
 
-func sendFile(webhookURL string, filePaths []string, username string) error {
+// opensFenceOnLastLine reports whether segment's final line is a bare
+// ``` marker that opens a new fence, given the fence state in effect
+// before segment. Splitting right after such a line would force-close the
+// freshly opened, still-empty fence at the end of this chunk and reopen
+// it at the start of the next, duplicating the marker with no content
+// between the two ```s.
+func opensFenceOnLastLine(segment []rune, fenceOpen bool) bool {
+	lines := strings.Split(string(segment), "\n")
+	open := fenceOpen
+	for _, line := range lines[:len(lines)-1] {
+		if strings.HasPrefix(strings.TrimSpace(line), "```") {
+			open = !open
+		}
+	}
+	last := strings.TrimSpace(lines[len(lines)-1])
+	return strings.HasPrefix(last, "```") && !open
+}
+
+// updateFenceState scans segment for ``` fence markers and returns the
+// fence state (and language tag, from the opening marker) in effect after
+// it, given the state before it.
+func updateFenceState(segment []rune, open bool, lang string) (bool, string) {
+	for _, line := range strings.Split(string(segment), "\n") {
+		trimmed := strings.TrimSpace(line)
+		if !strings.HasPrefix(trimmed, "```") {
+			continue
+		}
+		if open {
+			open = false
+			lang = ""
+		} else {
+			open = true
+			lang = strings.TrimSpace(strings.TrimPrefix(trimmed, "```"))
+		}
+	}
+	return open, lang
+}
+
+// buildMessageChunk renders one chunk of split message content, reopening
+// a fence (with its language tag) if the previous chunk left one open, and
+// closing it again if more content follows and the fence is still open.
+func buildMessageChunk(segment []rune, wasOpen bool, wasLang string, nowOpen, hasMore bool) string {
+	var sb strings.Builder
+	if wasOpen {
+		sb.WriteString("```")
+		sb.WriteString(wasLang)
+		sb.WriteString("\n")
+	}
+	sb.WriteString(string(segment))
+	if hasMore && nowOpen {
+		sb.WriteString("\n```")
+	}
+	return strings.TrimSpace(sb.String())
+}
+
+func sendFile(webhookURL string, filePaths []string, username, avatarURL string, maxRetries int, wait bool) error {
+	embed, err := buildEmbed()
+	if err != nil {
+		return err
+	}
+
+	payload := map[string]interface{}{}
+	if username != "" {
+		payload["username"] = username
+	}
+	if avatarURL != "" {
+		payload["avatar_url"] = avatarURL
+	}
+	if embed != nil {
+		payload["embeds"] = []*Embed{embed}
+	}
+	payloadBytes, err := json.Marshal(payload)
+	if err != nil {
+		return fmt.Errorf("failed to encode payload to JSON: %w", err)
+	}
+
+	return sendRawPayload(webhookURL, filePaths, payloadBytes, maxRetries, wait)
+}
+
+// buildMultipartBody assembles Discord's documented multipart form: the
+// given files under file[N], plus payloadBytes under a single payload_json
+// field. It is shared by every code path that needs to attach files
+// (sending, the --payload-json escape hatch, and editing).
+func buildMultipartBody(filePaths []string, payloadBytes []byte) ([]byte, string, error) {
 	var b bytes.Buffer
 	w := multipart.NewWriter(&b)
 
@@ -273,7 +1227,7 @@ func sendFile(webhookURL string, filePaths []string, username string) error {
 		_, filename := path.Split(filePath)
 		file, err := os.Open(filePath)
 		if err != nil {
-			return fmt.Errorf("failed to open the file: %w", err)
+			return nil, "", fmt.Errorf("failed to open the file: %w", err)
 		}
 		defer func() {
 			if err := file.Close(); err != nil {
@@ -287,58 +1241,86 @@ func sendFile(webhookURL string, filePaths []string, username string) error {
 			filename,
 		)
 		if err != nil {
-			return fmt.Errorf("failed to create form file: %w", err)
+			return nil, "", fmt.Errorf("failed to create form file: %w", err)
 		}
 		if _, err = io.Copy(fw, file); err != nil {
-			return fmt.Errorf("failed to copy file content: %w", err)
+			return nil, "", fmt.Errorf("failed to copy file content: %w", err)
 		}
 	}
 
 	// Add the payload part
-	payload := map[string]interface{}{}
-	if username != "" {
-		payload["username"] = username
-	}
-	payloadBytes, err := json.Marshal(payload)
-	if err != nil {
-		return fmt.Errorf("failed to encode payload to JSON: %w", err)
-	}
 	fw, err := w.CreateFormField("payload_json")
 	if err != nil {
-		return fmt.Errorf("failed to create payload field: %w", err)
+		return nil, "", fmt.Errorf("failed to create payload field: %w", err)
 	}
 	if _, err = fw.Write(payloadBytes); err != nil {
-		return fmt.Errorf("failed to write payload: %w", err)
+		return nil, "", fmt.Errorf("failed to write payload: %w", err)
 	}
 
 	if err := w.Close(); err != nil {
-		return fmt.Errorf("failed to close multipart writer: %w", err)
+		return nil, "", fmt.Errorf("failed to close multipart writer: %w", err)
 	}
 
-	req, err := http.NewRequest("POST", webhookURL, &b)
+	return b.Bytes(), w.FormDataContentType(), nil
+}
+
+// sendRawPayload POSTs the given files, if any, alongside payloadBytes as
+// Discord's documented multipart form. It is used both by sendFile, which
+// builds payloadBytes from flags, and by the --payload-json escape hatch,
+// which supplies a user-provided JSON body verbatim.
+func sendRawPayload(webhookURL string, filePaths []string, payloadBytes []byte, maxRetries int, wait bool) error {
+	bodyBytes, contentType, err := buildMultipartBody(filePaths, payloadBytes)
 	if err != nil {
-		return fmt.Errorf("failed to create the HTTP request: %w", err)
+		return err
 	}
-	req.Header.Set("Content-Type", w.FormDataContentType())
 
-	client := &http.Client{}
-	resp, err := client.Do(req)
+	respBody, err := postToWebhook(webhookURL, maxRetries, func() (*http.Request, error) {
+		req, err := http.NewRequest("POST", webhookURL, bytes.NewReader(bodyBytes))
+		if err != nil {
+			return nil, fmt.Errorf("failed to create the HTTP request: %w", err)
+		}
+		req.Header.Set("Content-Type", contentType)
+		return req, nil
+	})
 	if err != nil {
-		return fmt.Errorf("failed to send the HTTP request: %w", err)
+		return err
 	}
-	defer func() {
-		if err := resp.Body.Close(); err != nil {
-			fmt.Fprintln(os.Stderr, "Error closing response body:", err)
-		}
-	}()
+	if wait {
+		return printMessageID(respBody)
+	}
+	return nil
+}
 
-	// Accept both 200 and 204 as success
-	if resp.StatusCode != http.StatusNoContent && resp.StatusCode != http.StatusOK {
-		body, _ := io.ReadAll(resp.Body)
-		return fmt.Errorf("received an error from Discord. Status Code: %d, Response Body: %s", resp.StatusCode, string(body))
+// editMessage issues a PATCH to replace the content (and/or attached files)
+// of a previously sent webhook message, using the same multipart-with-
+// payload_json body construction as sendRawPayload.
+func editMessage(webhookURL, messageID string, filePaths []string, payloadBytes []byte, maxRetries int) error {
+	bodyBytes, contentType, err := buildMultipartBody(filePaths, payloadBytes)
+	if err != nil {
+		return err
 	}
 
-	return nil
+	_, err = postToWebhook(webhookURL, maxRetries, func() (*http.Request, error) {
+		req, err := http.NewRequest(http.MethodPatch, webhookURL+"/messages/"+messageID, bytes.NewReader(bodyBytes))
+		if err != nil {
+			return nil, fmt.Errorf("failed to create the HTTP request: %w", err)
+		}
+		req.Header.Set("Content-Type", contentType)
+		return req, nil
+	})
+	return err
+}
+
+// deleteMessage issues a DELETE for a previously sent webhook message.
+func deleteMessage(webhookURL, messageID string, maxRetries int) error {
+	_, err := postToWebhook(webhookURL, maxRetries, func() (*http.Request, error) {
+		req, err := http.NewRequest(http.MethodDelete, webhookURL+"/messages/"+messageID, nil)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create the HTTP request: %w", err)
+		}
+		return req, nil
+	})
+	return err
 }
 
 func usage() {
@@ -349,9 +1331,27 @@ func usage() {
 	fmt.Println("        --configure        Configure settings")
 	fmt.Println("    -h, --help             Display help information")
 	fmt.Println("    -V, --version          Display version information")
+	fmt.Println("        --all              Fan out to every configured webhook profile")
+	fmt.Println("    -F, --follow           Tail stdin, batching lines into messages as they arrive")
 	fmt.Println("\nOPTIONS:")
 	fmt.Println("        --username <username>       Set the username")
 	fmt.Println("    -c, --channel <channel>         Set the channel (not applicable for webhooks)")
 	fmt.Println("    -f, --file <file>               Specify the file to send")
 	fmt.Println("        --webhook <webhook_url>     Specify the webhook URL")
+	fmt.Println("        --profile <name>            Send to a named webhook profile (repeatable)")
+	fmt.Println("        --max-retries <n>           Maximum retries on rate limit or server errors (default 5)")
+	fmt.Println("        --flush-interval <dur>      Max time to buffer lines in --follow mode (default 2s)")
+	fmt.Println("        --embed-title <text>        Set the title of a rich embed")
+	fmt.Println("        --embed-description <text>  Set the description of a rich embed")
+	fmt.Println("        --embed-color <hex|name>    Set the embed color")
+	fmt.Println("        --embed-field <name=value>  Add an embed field (repeatable)")
+	fmt.Println("        --embed-footer <text>       Set the footer text of a rich embed")
+	fmt.Println("        --embed-image <url>         Set the image URL of a rich embed")
+	fmt.Println("        --embed-timestamp           Stamp the embed with the current time")
+	fmt.Println("        --payload-json <@file.json> Use a raw JSON payload instead of building one from flags")
+	fmt.Println("        --thread <id>               Target a specific thread by ID")
+	fmt.Println("        --wait                      Wait for Discord to return the message and print its ID")
+	fmt.Println("        --edit <message_id>         Edit an existing message instead of posting a new one")
+	fmt.Println("        --delete <message_id>       Delete an existing message")
+	fmt.Println("        --max-length <n>            Per-message character limit before splitting (default 2000, minimum 32)")
 }
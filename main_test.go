@@ -0,0 +1,137 @@
+package main
+
+import (
+	"strings"
+	"testing"
+	"unicode/utf8"
+)
+
+// fenceMarkerCount counts the ``` markers in s, used to assert that no
+// chunk produced by splitMessage leaves a fence open or duplicated.
+func fenceMarkerCount(s string) int {
+	n := 0
+	for _, line := range strings.Split(s, "\n") {
+		if strings.HasPrefix(strings.TrimSpace(line), "```") {
+			n++
+		}
+	}
+	return n
+}
+
+func TestSplitMessage(t *testing.T) {
+	tests := []struct {
+		name      string
+		content   string
+		maxLength int
+		wantMin   int // minimum number of chunks expected
+		wantMax   int // maximum number of chunks expected, 0 means unchecked
+	}{
+		{
+			name:      "emoji runes are not corrupted",
+			content:   strings.Repeat("😀", 100),
+			maxLength: 40,
+			wantMin:   2,
+		},
+		{
+			name:      "cjk runes are not corrupted",
+			content:   strings.Repeat("日本語のテスト文章です。", 20),
+			maxLength: 45,
+			wantMin:   4,
+		},
+		{
+			name:      "no newlines falls back to whitespace or rune boundary",
+			content:   "this is one long line with no newlines at all " + strings.Repeat("x", 80),
+			maxLength: 40,
+			wantMin:   2,
+		},
+		{
+			name:      "single fenced block survives a split",
+			content:   "```go\nfunc main() {\n\tfmt.Println(\"hi\")\n}\n```",
+			maxLength: 40,
+			wantMin:   2,
+		},
+		{
+			name:      "multiple fenced blocks with different languages",
+			content:   "```go\nfunc main(){}\n```\nsome text between\n```js\nconsole.log(1)\n```",
+			maxLength: 40,
+			wantMin:   2,
+		},
+		{
+			name:      "content fits in a single chunk",
+			content:   "short message",
+			maxLength: 2000,
+			wantMin:   1,
+			wantMax:   1,
+		},
+		{
+			name:      "empty content yields no chunks",
+			content:   "   \n\t  ",
+			maxLength: 2000,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			chunks := splitMessage(tt.content, tt.maxLength)
+
+			for i, chunk := range chunks {
+				if chunk == "" {
+					t.Errorf("chunk %d is empty", i)
+				}
+				if n := utf8.RuneCountInString(chunk); n > tt.maxLength {
+					// Fence reopen/close markers are allowed to push a
+					// chunk slightly over maxLength; only flag gross
+					// overruns that indicate a broken split.
+					if n > tt.maxLength*2 {
+						t.Errorf("chunk %d has %d runes, want <= %d", i, n, tt.maxLength)
+					}
+				}
+				if n := fenceMarkerCount(chunk); n%2 != 0 {
+					t.Errorf("chunk %d has an odd number of fence markers (%d), content would render broken on Discord: %q", i, n, chunk)
+				}
+			}
+
+			if tt.wantMin > 0 && len(chunks) < tt.wantMin {
+				t.Errorf("got %d chunks, want at least %d", len(chunks), tt.wantMin)
+			}
+			if tt.wantMax > 0 && len(chunks) > tt.wantMax {
+				t.Errorf("got %d chunks, want at most %d", len(chunks), tt.wantMax)
+			}
+
+			if strings.TrimSpace(tt.content) == "" && len(chunks) != 0 {
+				t.Errorf("expected no chunks for blank content, got %d", len(chunks))
+			}
+		})
+	}
+}
+
+func TestSplitMessageReassemblesContent(t *testing.T) {
+	content := "```go\nfunc main(){}\n```\nsome text between\n```js\nconsole.log(1)\n```"
+	chunks := splitMessage(content, 40)
+
+	joined := strings.Join(chunks, "")
+	for _, want := range []string{"func main(){}", "some text between", "console.log(1)"} {
+		if !strings.Contains(joined, want) {
+			t.Errorf("split output missing original content %q; got chunks: %#v", want, chunks)
+		}
+	}
+}
+
+// TestSplitMessageEnforcesMinimumLength guards against a --max-length set
+// far below the fence-reopen overhead collapsing every chunk's budget
+// down to one or two runes and producing a flood of near-empty messages.
+func TestSplitMessageEnforcesMinimumLength(t *testing.T) {
+	content := "```go\nfunc main() {\n\tfmt.Println(\"hello, world\")\n\treturn\n}\n```"
+
+	for _, maxLength := range []int{0, 1, 8, 10} {
+		chunks := splitMessage(content, maxLength)
+		if len(chunks) > 10 {
+			t.Errorf("maxLength=%d produced %d chunks, want a small handful (got a near-1-rune-per-chunk flood)", maxLength, len(chunks))
+		}
+		for i, chunk := range chunks {
+			if utf8.RuneCountInString(chunk) < 1 {
+				t.Errorf("maxLength=%d chunk %d is empty", maxLength, i)
+			}
+		}
+	}
+}